@@ -0,0 +1,139 @@
+// Package amazon provides a typed client for scraping Amazon product pages
+// and decoding them into Go structs. Retrieval is delegated to a
+// fetch.Fetcher, so the client works against ZenRows or any other backend.
+package amazon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ZenRows/amazon-scraper/antibot"
+	"github.com/ZenRows/amazon-scraper/fetch"
+	"github.com/ZenRows/amazon-scraper/retry"
+)
+
+// Client fetches Amazon pages through a fetch.Fetcher and decodes
+// ZenRows-shaped autoparse responses into typed structs.
+type Client struct {
+	fetcher fetch.Fetcher
+
+	captchaRecovery bool
+	proxyCountries  []string
+	retryOpts       []retry.Option
+}
+
+// ClientOption configures a Client constructed with New.
+type ClientOption func(*Client)
+
+// WithFetcher overrides the backend used to retrieve pages. Defaults to a
+// fetch.ZenRowsFetcher authenticated with the apikey passed to New.
+func WithFetcher(f fetch.Fetcher) ClientOption {
+	return func(c *Client) {
+		c.fetcher = f
+	}
+}
+
+// WithMaxRetries sets how many additional attempts are made after a
+// retryable failure (429, 500, 502, 503, 504, or a transport error),
+// beyond the first. Defaults to 3 once any retry option (WithMaxRetries,
+// WithBackoff, WithConcurrency, WithCircuitBreaker) is passed; a Client
+// built with none of them makes a single attempt per request.
+func WithMaxRetries(n int) ClientOption {
+	return func(c *Client) {
+		c.retryOpts = append(c.retryOpts, retry.WithMaxRetries(n))
+	}
+}
+
+// WithBackoff overrides the exponential backoff strategy used between
+// retries. Defaults to retry.DefaultBackoff.
+func WithBackoff(b retry.Backoff) ClientOption {
+	return func(c *Client) {
+		c.retryOpts = append(c.retryOpts, retry.WithBackoff(b))
+	}
+}
+
+// WithConcurrency bounds how many requests the client keeps in flight at
+// once, to respect the API key's concurrency budget. n <= 0 (including
+// leaving this option unset) means unbounded.
+func WithConcurrency(n int) ClientOption {
+	return func(c *Client) {
+		c.retryOpts = append(c.retryOpts, retry.WithConcurrency(n))
+	}
+}
+
+// WithCircuitBreaker trips after threshold consecutive quota/auth
+// failures, refusing further requests with retry.ErrCircuitOpen until a
+// probe request after retry.DefaultCircuitBreakerCooldown succeeds. This
+// stops a bad run (e.g. an expired key) from burning through retries on
+// every request, while still recovering on its own.
+func WithCircuitBreaker(threshold int) ClientOption {
+	return func(c *Client) {
+		c.retryOpts = append(c.retryOpts, retry.WithCircuitBreaker(threshold))
+	}
+}
+
+// WithCaptchaRecovery enables automatic recovery from CAPTCHA/soft-block
+// responses: on detection, the request is retried with premium proxy,
+// then each of proxyCountries in turn, then JS rendering, before giving
+// up. See antibot.Check for what counts as blocked.
+func WithCaptchaRecovery(proxyCountries ...string) ClientOption {
+	return func(c *Client) {
+		c.captchaRecovery = true
+		c.proxyCountries = proxyCountries
+	}
+}
+
+// New creates a Client. apikey authenticates the default ZenRows backend;
+// it's ignored if WithFetcher is also passed.
+func New(apikey string, opts ...ClientOption) *Client {
+	c := &Client{
+		fetcher: fetch.NewZenRowsFetcher(apikey),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.captchaRecovery {
+		c.fetcher = antibot.Recover(c.fetcher, antibot.WithProxyCountries(c.proxyCountries))
+	}
+	if len(c.retryOpts) > 0 {
+		c.fetcher = retry.Wrap(c.fetcher, c.retryOpts...)
+	}
+	return c
+}
+
+// FetchProduct retrieves and decodes a single Amazon product page at
+// productURL.
+func (c *Client) FetchProduct(ctx context.Context, productURL string, opts RequestOptions) (*Product, error) {
+	body, err := c.get(ctx, productURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var product Product
+	if err := json.Unmarshal(body, &product); err != nil {
+		return nil, fmt.Errorf("amazon: decoding product response: %w", err)
+	}
+	return &product, nil
+}
+
+// get fetches targetURL through the configured backend and returns the raw
+// autoparse response body, or a typed error from classifyError.
+func (c *Client) get(ctx context.Context, targetURL string, opts RequestOptions) ([]byte, error) {
+	resp, err := c.fetcher.Fetch(ctx, targetURL, opts.toFetchOptions())
+	if err != nil {
+		return nil, fmt.Errorf("amazon: fetching %s: %w", targetURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("amazon: reading response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, classifyError(resp.StatusCode, body)
+	}
+	return body, nil
+}
@@ -0,0 +1,62 @@
+package amazon
+
+import "fmt"
+
+// APIError is returned when ZenRows responds with a non-2xx status that
+// doesn't map to one of the more specific error types below.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("amazon: zenrows request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// QuotaExceededError is returned when the ZenRows account has run out of
+// API credits (HTTP 402).
+type QuotaExceededError struct {
+	*APIError
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("amazon: zenrows quota exceeded: %s", e.Body)
+}
+
+// RateLimitedError is returned when ZenRows throttles the request (HTTP 429).
+// It is not a hard failure: callers should back off and retry.
+type RateLimitedError struct {
+	*APIError
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("amazon: rate limited by zenrows: %s", e.Body)
+}
+
+// BlockedError is returned when ZenRows could not reach Amazon on the
+// caller's behalf, either because Amazon blocked the request or the target
+// URL was rejected upstream (HTTP 422).
+type BlockedError struct {
+	*APIError
+}
+
+func (e *BlockedError) Error() string {
+	return fmt.Sprintf("amazon: request blocked upstream: %s", e.Body)
+}
+
+// classifyError turns a non-2xx ZenRows response into one of the typed
+// errors above so callers can branch with errors.As instead of parsing
+// status codes themselves.
+func classifyError(statusCode int, body []byte) error {
+	base := &APIError{StatusCode: statusCode, Body: string(body)}
+	switch statusCode {
+	case 402:
+		return &QuotaExceededError{base}
+	case 422:
+		return &BlockedError{base}
+	case 429:
+		return &RateLimitedError{base}
+	default:
+		return base
+	}
+}
@@ -0,0 +1,37 @@
+package amazon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ListingItem is one entry in a search results or category page, as
+// returned by ZenRows' autoparse for amazon.com/s and amazon.com/b pages.
+type ListingItem struct {
+	ASIN        string    `json:"asin"`
+	Title       string    `json:"title"`
+	URL         string    `json:"url,omitempty"`
+	Price       FlexFloat `json:"price,omitempty"`
+	Rating      FlexFloat `json:"rating,omitempty"`
+	IsSponsored bool      `json:"is_sponsored,omitempty"`
+}
+
+type listingResponse struct {
+	Products []ListingItem `json:"products"`
+}
+
+// FetchListing retrieves and decodes a single search or category results
+// page at listingURL.
+func (c *Client) FetchListing(ctx context.Context, listingURL string, opts RequestOptions) ([]ListingItem, error) {
+	body, err := c.get(ctx, listingURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp listingResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("amazon: decoding listing response: %w", err)
+	}
+	return resp.Products, nil
+}
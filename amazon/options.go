@@ -0,0 +1,34 @@
+package amazon
+
+import "github.com/ZenRows/amazon-scraper/fetch"
+
+// RequestOptions configures a single product/listing fetch. The zero
+// value is a plain fetch with no JS rendering or proxy escalation.
+type RequestOptions struct {
+	// JSRender enables headless browser rendering for pages that
+	// hydrate content client-side.
+	JSRender bool
+	// PremiumProxy routes the request through the backend's
+	// anti-block/residential proxy pool, at a higher credit cost.
+	PremiumProxy bool
+	// ProxyCountry pins the exit IP to a country code, e.g. "us".
+	ProxyCountry string
+	// WaitFor is a CSS selector the backend should wait to appear before
+	// returning the page. Requires JSRender.
+	WaitFor string
+	// CSSExtractor is a custom CSS extraction ruleset, for fields the
+	// backend's built-in parser doesn't cover.
+	CSSExtractor string
+}
+
+// toFetchOptions translates RequestOptions to the backend-agnostic
+// fetch.FetchOptions understood by a fetch.Fetcher.
+func (o RequestOptions) toFetchOptions() fetch.FetchOptions {
+	return fetch.FetchOptions{
+		JSRender:     o.JSRender,
+		PremiumProxy: o.PremiumProxy,
+		ProxyCountry: o.ProxyCountry,
+		WaitFor:      o.WaitFor,
+		CSSExtractor: o.CSSExtractor,
+	}
+}
@@ -0,0 +1,26 @@
+package amazon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type reviewsPageResponse struct {
+	Reviews []Review `json:"reviews"`
+}
+
+// FetchReviewsPage retrieves and decodes a single page of an Amazon
+// product's reviews at reviewsURL.
+func (c *Client) FetchReviewsPage(ctx context.Context, reviewsURL string, opts RequestOptions) ([]Review, error) {
+	body, err := c.get(ctx, reviewsURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp reviewsPageResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("amazon: decoding reviews response: %w", err)
+	}
+	return resp.Reviews, nil
+}
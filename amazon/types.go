@@ -0,0 +1,211 @@
+package amazon
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Product is the decoded shape of ZenRows' autoparse response for an
+// Amazon product page.
+type Product struct {
+	ASIN         string           `json:"asin"`
+	URL          string           `json:"url,omitempty"`
+	Title        string           `json:"title"`
+	Brand        string           `json:"brand,omitempty"`
+	Price        FlexFloat        `json:"price"`
+	ListPrice    FlexFloat        `json:"list_price,omitempty"`
+	Currency     string           `json:"currency,omitempty"`
+	Rating       FlexFloat        `json:"rating"`
+	ReviewsCount int              `json:"reviews_count,omitempty"`
+	Availability Availability     `json:"availability"`
+	Images       []string         `json:"images,omitempty"`
+	Features     []string         `json:"features,omitempty"`
+	Description  string           `json:"description,omitempty"`
+	Breadcrumbs  []BreadcrumbItem `json:"breadcrumbs,omitempty"`
+	Variations   []Variation      `json:"variations,omitempty"`
+	Offers       []Offer          `json:"offers,omitempty"`
+	Reviews      []Review         `json:"reviews,omitempty"`
+}
+
+// Offer is one seller's listing for a product, including the buy box
+// winner when present.
+type Offer struct {
+	SellerName     string    `json:"seller_name,omitempty"`
+	Price          FlexFloat `json:"price"`
+	Currency       string    `json:"currency,omitempty"`
+	Condition      string    `json:"condition,omitempty"`
+	IsPrime        bool      `json:"is_prime,omitempty"`
+	IsBuyBoxWinner bool      `json:"is_buybox_winner,omitempty"`
+}
+
+// Review is a single customer review attached to a product.
+type Review struct {
+	ID               string    `json:"id,omitempty"`
+	Author           string    `json:"author,omitempty"`
+	Rating           FlexFloat `json:"rating"`
+	Title            string    `json:"title,omitempty"`
+	Body             string    `json:"body,omitempty"`
+	Date             string    `json:"date,omitempty"`
+	VerifiedPurchase bool      `json:"verified_purchase,omitempty"`
+	HelpfulVotes     int       `json:"helpful_votes,omitempty"`
+}
+
+// Variation is a sibling ASIN reachable from the same product page, e.g.
+// a different size or color.
+type Variation struct {
+	ASIN       string            `json:"asin"`
+	Dimensions map[string]string `json:"dimensions,omitempty"`
+	Price      FlexFloat         `json:"price,omitempty"`
+	Available  bool              `json:"available,omitempty"`
+}
+
+// BreadcrumbItem is one entry in the category breadcrumb trail shown at
+// the top of a product page.
+type BreadcrumbItem struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// Availability holds the stock status of a product. Amazon renders this
+// as free-form text ("In Stock", "Only 3 left in stock") so InStock is a
+// best-effort classification of Raw.
+type Availability struct {
+	Raw     string
+	InStock bool
+}
+
+// UnmarshalJSON accepts the autoparse shapes seen in the wild: a bare
+// string ("In Stock"), or an object with raw/in_stock fields.
+func (a *Availability) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	if data[0] == '"' {
+		var raw string
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		a.Raw = raw
+		a.InStock = inferInStock(raw)
+		return nil
+	}
+
+	var obj struct {
+		Raw     string `json:"raw"`
+		InStock *bool  `json:"in_stock"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	a.Raw = obj.Raw
+	if obj.InStock != nil {
+		a.InStock = *obj.InStock
+	} else {
+		a.InStock = inferInStock(obj.Raw)
+	}
+	return nil
+}
+
+func inferInStock(raw string) bool {
+	lower := strings.ToLower(raw)
+	if lower == "" {
+		return false
+	}
+	return !strings.Contains(lower, "unavailable") && !strings.Contains(lower, "out of stock")
+}
+
+// FlexFloat decodes a numeric autoparse field regardless of whether Amazon
+// (or ZenRows' parser) rendered it as a JSON number, a plain string
+// ("19.99"), or a currency-formatted string ("$19.99", "4.5 out of 5
+// stars").
+type FlexFloat float64
+
+// flexFloatObjectKeys are the fields checked, in order, when a numeric
+// autoparse field is rendered as an object instead of a string or number,
+// e.g. {"value": 19.99, "currency": "USD"}.
+var flexFloatObjectKeys = []string{"value", "amount", "raw", "price"}
+
+// UnmarshalJSON strips currency symbols, thousands separators, and any
+// trailing text before parsing the first numeric token it finds. An
+// object payload is handled by recursing into the first recognized
+// numeric field.
+func (f *FlexFloat) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	if data[0] == '{' {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return err
+		}
+		for _, key := range flexFloatObjectKeys {
+			if raw, ok := obj[key]; ok {
+				return f.UnmarshalJSON(raw)
+			}
+		}
+		*f = 0
+		return nil
+	}
+
+	if data[0] != '"' {
+		var num float64
+		if err := json.Unmarshal(data, &num); err != nil {
+			return err
+		}
+		*f = FlexFloat(num)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		*f = 0
+		return nil
+	}
+
+	token := firstNumericToken(s)
+	if token == "" {
+		*f = 0
+		return nil
+	}
+	num, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return err
+	}
+	*f = FlexFloat(num)
+	return nil
+}
+
+// firstNumericToken extracts the leading run of digits, '.', and ','
+// characters from s (e.g. "$1,299.99 (23% off)" -> "1,299.99"), then
+// strips thousands separators.
+func firstNumericToken(s string) string {
+	start := -1
+	end := -1
+	for i, r := range s {
+		isDigit := r >= '0' && r <= '9'
+		if isDigit && start == -1 {
+			start = i
+		}
+		if start != -1 {
+			if isDigit || r == '.' || r == ',' {
+				end = i + 1
+				continue
+			}
+			break
+		}
+	}
+	if start == -1 {
+		return ""
+	}
+	return strings.ReplaceAll(s[start:end], ",", "")
+}
@@ -0,0 +1,62 @@
+package amazon
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlexFloatUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name string
+		json string
+		want FlexFloat
+	}{
+		{"number", `19.99`, 19.99},
+		{"plain string", `"19.99"`, 19.99},
+		{"currency string", `"$1,299.99"`, 1299.99},
+		{"rating string", `"4.5 out of 5 stars"`, 4.5},
+		{"empty string", `""`, 0},
+		{"null", `null`, 0},
+		{"object with value", `{"value":19.99,"currency":"USD"}`, 19.99},
+		{"object with amount", `{"amount":"$1,299.99"}`, 1299.99},
+		{"object with no known keys", `{"currency":"USD"}`, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got FlexFloat
+			if err := json.Unmarshal([]byte(tc.json), &got); err != nil {
+				t.Fatalf("Unmarshal(%s): %v", tc.json, err)
+			}
+			if got != tc.want {
+				t.Errorf("Unmarshal(%s) = %v, want %v", tc.json, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAvailabilityUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name        string
+		json        string
+		wantRaw     string
+		wantInStock bool
+	}{
+		{"in stock string", `"In Stock"`, "In Stock", true},
+		{"out of stock string", `"Out of Stock"`, "Out of Stock", false},
+		{"object with explicit flag", `{"raw":"Temporarily unavailable","in_stock":false}`, "Temporarily unavailable", false},
+		{"object without flag", `{"raw":"In Stock"}`, "In Stock", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got Availability
+			if err := json.Unmarshal([]byte(tc.json), &got); err != nil {
+				t.Fatalf("Unmarshal(%s): %v", tc.json, err)
+			}
+			if got.Raw != tc.wantRaw || got.InStock != tc.wantInStock {
+				t.Errorf("Unmarshal(%s) = %+v, want {Raw:%s InStock:%v}", tc.json, got, tc.wantRaw, tc.wantInStock)
+			}
+		})
+	}
+}
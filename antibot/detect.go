@@ -0,0 +1,59 @@
+// Package antibot detects Amazon's CAPTCHA and soft-block pages so callers
+// don't silently record garbage into their datasets.
+package antibot
+
+import (
+	"bytes"
+	"errors"
+)
+
+// ErrCaptchaChallenge is returned when a response looks like a CAPTCHA
+// challenge, a "Robot Check" interstitial, or a blank/stale soft block
+// instead of real content.
+var ErrCaptchaChallenge = errors.New("antibot: captcha challenge detected")
+
+// challengeMarkers are substrings (matched case-insensitively) that appear
+// on Amazon's anti-bot interstitials.
+var challengeMarkers = [][]byte{
+	[]byte("enter the characters you see below"),
+	[]byte("sorry, we just need to make sure you're not a robot"),
+	[]byte("robot check"),
+	[]byte("to discuss automated access"),
+}
+
+// minProductPageBytes is a floor below which a 200 response is almost
+// certainly a blank soft block rather than a real product page.
+const minProductPageBytes = 256
+
+// Check inspects a response for Amazon's anti-bot markers and returns
+// ErrCaptchaChallenge if it finds one. A nil return means the response
+// looks like real content.
+func Check(statusCode int, body []byte) error {
+	if looksLikeChallenge(body) {
+		return ErrCaptchaChallenge
+	}
+	if looksLikeSoftBlock(statusCode, body) {
+		return ErrCaptchaChallenge
+	}
+	return nil
+}
+
+func looksLikeChallenge(body []byte) bool {
+	lower := bytes.ToLower(body)
+	for _, marker := range challengeMarkers {
+		if bytes.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// looksLikeSoftBlock flags a 200 OK whose body is too short to be a real
+// product/listing page: Amazon sometimes returns an empty or near-empty
+// 200 instead of an explicit block status.
+func looksLikeSoftBlock(statusCode int, body []byte) bool {
+	if statusCode != 200 {
+		return false
+	}
+	return len(bytes.TrimSpace(body)) < minProductPageBytes
+}
@@ -0,0 +1,38 @@
+package antibot
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	cases := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantBlock  bool
+	}{
+		{"real product page", 200, bigEnoughBody("<html>Logitech Master Mouse $49.99</html>"), false},
+		{"captcha marker", 200, "Enter the characters you see below", true},
+		{"robot check marker", 200, "Robot Check - Sorry!", true},
+		{"empty 200", 200, "", true},
+		{"short 200", 200, "ok", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Check(tc.statusCode, []byte(tc.body))
+			if tc.wantBlock && err != ErrCaptchaChallenge {
+				t.Errorf("Check() = %v, want ErrCaptchaChallenge", err)
+			}
+			if !tc.wantBlock && err != nil {
+				t.Errorf("Check() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func bigEnoughBody(snippet string) string {
+	padding := make([]byte, minProductPageBytes)
+	for i := range padding {
+		padding[i] = 'x'
+	}
+	return snippet + string(padding)
+}
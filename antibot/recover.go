@@ -0,0 +1,106 @@
+package antibot
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/ZenRows/amazon-scraper/fetch"
+)
+
+// RecoveringFetcher wraps a fetch.Fetcher and, on detecting a CAPTCHA or
+// soft-block response, retries through a ladder of escalating request
+// options before giving up: premium proxy, then alternate proxy
+// countries, then JS rendering.
+type RecoveringFetcher struct {
+	delegate       fetch.Fetcher
+	proxyCountries []string
+}
+
+// Option configures a RecoveringFetcher built with Recover.
+type Option func(*RecoveringFetcher)
+
+// WithProxyCountries sets the proxy countries tried in order when the
+// default country is blocked. Defaults to none (country isn't escalated).
+func WithProxyCountries(countries []string) Option {
+	return func(f *RecoveringFetcher) {
+		f.proxyCountries = countries
+	}
+}
+
+// Recover returns a Fetcher that retries delegate through escalating
+// anti-bot workarounds whenever Check flags the response.
+func Recover(delegate fetch.Fetcher, opts ...Option) *RecoveringFetcher {
+	f := &RecoveringFetcher{delegate: delegate}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Fetch tries targetURL, escalating through premium proxy, alternate
+// proxy countries, and JS rendering in turn until a response passes Check
+// or every step has been exhausted. The last response tried is returned
+// either way, so a caller that wants to inspect a final blocked page still
+// can.
+func (f *RecoveringFetcher) Fetch(ctx context.Context, targetURL string, opts fetch.FetchOptions) (*http.Response, error) {
+	var lastResp *http.Response
+	var lastErr error
+
+	for _, stepOpts := range f.escalationSteps(opts) {
+		resp, err := f.delegate.Fetch(ctx, targetURL, stepOpts)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		if checkErr := Check(resp.StatusCode, body); checkErr == nil {
+			return resp, nil
+		} else {
+			lastResp, lastErr = resp, checkErr
+		}
+	}
+
+	return lastResp, lastErr
+}
+
+// escalationSteps builds the sequence of FetchOptions to try, starting
+// from the caller's opts and only escalating settings the caller hasn't
+// already turned on.
+func (f *RecoveringFetcher) escalationSteps(opts fetch.FetchOptions) []fetch.FetchOptions {
+	steps := []fetch.FetchOptions{opts}
+
+	if !opts.PremiumProxy {
+		premium := opts
+		premium.PremiumProxy = true
+		steps = append(steps, premium)
+	}
+
+	for _, country := range f.proxyCountries {
+		if country == opts.ProxyCountry {
+			continue
+		}
+		variant := opts
+		variant.PremiumProxy = true
+		variant.ProxyCountry = country
+		steps = append(steps, variant)
+	}
+
+	if !opts.JSRender {
+		rendered := opts
+		rendered.JSRender = true
+		rendered.PremiumProxy = true
+		steps = append(steps, rendered)
+	}
+
+	return steps
+}
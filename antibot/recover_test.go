@@ -0,0 +1,61 @@
+package antibot
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ZenRows/amazon-scraper/fetch"
+)
+
+type recordingFetcher struct {
+	responses []string
+	seenOpts  []fetch.FetchOptions
+}
+
+func (f *recordingFetcher) Fetch(_ context.Context, _ string, opts fetch.FetchOptions) (*http.Response, error) {
+	f.seenOpts = append(f.seenOpts, opts)
+	body := f.responses[len(f.seenOpts)-1]
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func TestRecoverEscalatesThroughPremiumProxyThenJSRender(t *testing.T) {
+	delegate := &recordingFetcher{
+		responses: []string{
+			"Robot Check",                      // plain request: blocked
+			"Robot Check",                      // premium proxy: still blocked
+			bigEnoughBody("real product page"), // JS render: clean
+		},
+	}
+
+	f := Recover(delegate)
+	resp, err := f.Fetch(context.Background(), "https://example.com", fetch.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if len(delegate.seenOpts) != 3 {
+		t.Fatalf("delegate called %d times, want 3", len(delegate.seenOpts))
+	}
+	if !delegate.seenOpts[1].PremiumProxy {
+		t.Errorf("2nd attempt PremiumProxy = false, want true")
+	}
+	if !delegate.seenOpts[2].JSRender {
+		t.Errorf("3rd attempt JSRender = false, want true")
+	}
+}
+
+func TestRecoverGivesUpAfterExhaustingSteps(t *testing.T) {
+	delegate := &recordingFetcher{
+		responses: []string{"Robot Check", "Robot Check", "Robot Check"},
+	}
+
+	f := Recover(delegate)
+	_, err := f.Fetch(context.Background(), "https://example.com", fetch.FetchOptions{})
+	if err != ErrCaptchaChallenge {
+		t.Errorf("Fetch() error = %v, want ErrCaptchaChallenge", err)
+	}
+}
@@ -0,0 +1,279 @@
+// Package crawler provides high-level, paginated entry points for
+// scraping Amazon (product, search, category, reviews, seller listings)
+// on top of the amazon.Client single-page fetch.
+package crawler
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ZenRows/amazon-scraper/amazon"
+)
+
+const defaultConcurrency = 4
+
+// Crawler fans paginated Amazon crawls out across a bounded worker pool,
+// deduplicating ASINs and streaming products as they're fetched.
+type Crawler struct {
+	client      *amazon.Client
+	concurrency int
+	reqOpts     amazon.RequestOptions
+}
+
+// Option configures a Crawler constructed with New.
+type Option func(*Crawler)
+
+// WithConcurrency bounds how many ZenRows requests the crawler keeps in
+// flight at once, across both listing pages and product fetches.
+func WithConcurrency(n int) Option {
+	return func(c *Crawler) {
+		if n > 0 {
+			c.concurrency = n
+		}
+	}
+}
+
+// WithRequestOptions sets the amazon.RequestOptions applied to every
+// request the crawler makes.
+func WithRequestOptions(opts amazon.RequestOptions) Option {
+	return func(c *Crawler) {
+		c.reqOpts = opts
+	}
+}
+
+// New creates a Crawler backed by client.
+func New(client *amazon.Client, opts ...Option) *Crawler {
+	c := &Crawler{
+		client:      client,
+		concurrency: defaultConcurrency,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// FetchProduct retrieves a single product page by ASIN.
+func (c *Crawler) FetchProduct(ctx context.Context, asin string) (*amazon.Product, error) {
+	return c.client.FetchProduct(ctx, productURL(asin), c.reqOpts)
+}
+
+// SearchKeyword crawls up to pages of amazon.com search results for
+// keyword, streaming the full product page for each ASIN found. The
+// returned func does not wait for the crawl: it reports on the fetches
+// finished when it is called, so its answer is final only once the
+// product channel has been drained and closed. It then returns a non-nil
+// error only if every fetch in the crawl failed (so callers can tell
+// "found nothing" from "the crawl never worked").
+func (c *Crawler) SearchKeyword(ctx context.Context, keyword string, pages int) (<-chan amazon.Product, func() error) {
+	return c.crawlListings(ctx, pages, func(page int) string {
+		return searchURL(keyword, page)
+	})
+}
+
+// CrawlCategory crawls up to pages of a category's (browse node's)
+// listing pages, streaming the full product page for each ASIN found. See
+// SearchKeyword for how to interpret the returned func.
+func (c *Crawler) CrawlCategory(ctx context.Context, node string, pages int) (<-chan amazon.Product, func() error) {
+	return c.crawlListings(ctx, pages, func(page int) string {
+		return categoryURL(node, page)
+	})
+}
+
+// FetchSellerListings crawls the first page of a third-party seller's
+// storefront, streaming the full product page for each ASIN found; later
+// storefront pages are not fetched. See SearchKeyword
+// for how to interpret the returned func.
+func (c *Crawler) FetchSellerListings(ctx context.Context, sellerID string) (<-chan amazon.Product, func() error) {
+	return c.crawlListings(ctx, 1, func(page int) string {
+		return sellerListingsURL(sellerID, page)
+	})
+}
+
+// FetchReviews crawls up to pages of an ASIN's reviews, streaming each
+// review as its page is fetched. The returned func does not wait for the
+// crawl: it reports on the page fetches finished when it is called, so its
+// answer is final only once the review channel has been drained and
+// closed. It then returns a non-nil error only if every page fetch in the
+// crawl failed.
+func (c *Crawler) FetchReviews(ctx context.Context, asin string, pages int) (<-chan amazon.Review, func() error) {
+	out := make(chan amazon.Review)
+	tracker := &crawlTracker{}
+
+	go func() {
+		defer close(out)
+
+		sem := make(chan struct{}, c.concurrency)
+		var wg sync.WaitGroup
+
+		for page := 1; page <= pages; page++ {
+			page := page
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if !acquire(ctx, sem) {
+					return
+				}
+				defer release(sem)
+
+				reviews, err := c.client.FetchReviewsPage(ctx, reviewsURL(asin, page), c.reqOpts)
+				if err != nil {
+					tracker.recordFailure(err)
+					return
+				}
+				tracker.recordSuccess()
+				for _, review := range reviews {
+					select {
+					case out <- review:
+						tracker.recordSuccess()
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	}()
+
+	return out, tracker.err
+}
+
+// crawlListings fetches pages 1..pages concurrently through pageURL,
+// deduplicates the ASINs found across every page, then fetches each
+// unique product concurrently and streams it to the returned channel.
+// Both stages respect ctx cancellation and the crawler's concurrency
+// bound. See SearchKeyword for how to interpret the returned func.
+func (c *Crawler) crawlListings(ctx context.Context, pages int, pageURL func(page int) string) (<-chan amazon.Product, func() error) {
+	out := make(chan amazon.Product)
+	tracker := &crawlTracker{}
+
+	go func() {
+		defer close(out)
+
+		items := make(chan amazon.ListingItem)
+		var pageWG sync.WaitGroup
+		pageSem := make(chan struct{}, c.concurrency)
+
+		for page := 1; page <= pages; page++ {
+			page := page
+			pageWG.Add(1)
+			go func() {
+				defer pageWG.Done()
+				if !acquire(ctx, pageSem) {
+					return
+				}
+				defer release(pageSem)
+
+				listing, err := c.client.FetchListing(ctx, pageURL(page), c.reqOpts)
+				if err != nil {
+					tracker.recordFailure(err)
+					return
+				}
+				tracker.recordSuccess()
+				for _, item := range listing {
+					select {
+					case items <- item:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			pageWG.Wait()
+			close(items)
+		}()
+
+		var seenMu sync.Mutex
+		seen := make(map[string]struct{})
+		// Product fetches share the listing stage's slots, so both stages
+		// together stay within the crawler's concurrency bound.
+		productSem := pageSem
+		var productWG sync.WaitGroup
+
+		for item := range items {
+			seenMu.Lock()
+			_, dup := seen[item.ASIN]
+			if !dup {
+				seen[item.ASIN] = struct{}{}
+			}
+			seenMu.Unlock()
+			if dup {
+				continue
+			}
+
+			asin := item.ASIN
+			productWG.Add(1)
+			go func() {
+				defer productWG.Done()
+				if !acquire(ctx, productSem) {
+					return
+				}
+				defer release(productSem)
+
+				product, err := c.FetchProduct(ctx, asin)
+				if err != nil {
+					tracker.recordFailure(err)
+					return
+				}
+				select {
+				case out <- *product:
+					tracker.recordSuccess()
+				case <-ctx.Done():
+				}
+			}()
+		}
+		productWG.Wait()
+	}()
+
+	return out, tracker.err
+}
+
+// crawlTracker collects fetch failures across a crawl's goroutines so a
+// totally failed crawl (e.g. an expired API key rejecting every request)
+// can be told apart from one that legitimately found nothing.
+type crawlTracker struct {
+	mu        sync.Mutex
+	errs      []error
+	succeeded bool
+}
+
+func (t *crawlTracker) recordFailure(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.errs = append(t.errs, err)
+}
+
+func (t *crawlTracker) recordSuccess() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.succeeded = true
+}
+
+// err reports the joined fetch errors, but only once every attempt in the
+// crawl failed; a crawl with at least one success returns nil even if
+// some individual pages/products errored.
+func (t *crawlTracker) err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.succeeded || len(t.errs) == 0 {
+		return nil
+	}
+	return errors.Join(t.errs...)
+}
+
+// acquire takes a slot from sem, returning false without taking one if ctx
+// is cancelled first.
+func acquire(ctx context.Context, sem chan struct{}) bool {
+	select {
+	case sem <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func release(sem chan struct{}) {
+	<-sem
+}
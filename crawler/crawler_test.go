@@ -0,0 +1,67 @@
+package crawler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ZenRows/amazon-scraper/amazon"
+	"github.com/ZenRows/amazon-scraper/fetch"
+)
+
+// stubFetcher serves canned bodies based on whether the requested URL
+// looks like a listing page (amazon.com/s) or a product page (amazon.com/dp).
+type stubFetcher struct {
+	listingBody   string
+	productBody   string
+	listingStatus int
+	productStatus int
+}
+
+func (f *stubFetcher) Fetch(_ context.Context, targetURL string, _ fetch.FetchOptions) (*http.Response, error) {
+	if strings.Contains(targetURL, "/dp/") {
+		return &http.Response{StatusCode: f.productStatus, Body: io.NopCloser(strings.NewReader(f.productBody))}, nil
+	}
+	return &http.Response{StatusCode: f.listingStatus, Body: io.NopCloser(strings.NewReader(f.listingBody))}, nil
+}
+
+func drainProducts(ch <-chan amazon.Product) int {
+	n := 0
+	for range ch {
+		n++
+	}
+	return n
+}
+
+func TestCrawlListingsAllFailuresReportError(t *testing.T) {
+	client := amazon.New("key", amazon.WithFetcher(&stubFetcher{listingStatus: 500, productStatus: 500}))
+	c := New(client)
+
+	products, lastErr := c.SearchKeyword(context.Background(), "mouse", 2)
+	if n := drainProducts(products); n != 0 {
+		t.Fatalf("got %d products, want 0", n)
+	}
+	if err := lastErr(); err == nil {
+		t.Fatalf("lastErr() = nil, want an error when every listing fetch failed")
+	}
+}
+
+func TestCrawlListingsPartialSuccessReportsNoError(t *testing.T) {
+	client := amazon.New("key", amazon.WithFetcher(&stubFetcher{
+		listingBody:   `{"products":[{"asin":"B0FB21526X"}]}`,
+		listingStatus: 200,
+		productBody:   `{"asin":"B0FB21526X","title":"Logitech Mouse"}`,
+		productStatus: 200,
+	}))
+	c := New(client)
+
+	products, lastErr := c.SearchKeyword(context.Background(), "mouse", 1)
+	if n := drainProducts(products); n != 1 {
+		t.Fatalf("got %d products, want 1", n)
+	}
+	if err := lastErr(); err != nil {
+		t.Fatalf("lastErr() = %v, want nil after a successful fetch", err)
+	}
+}
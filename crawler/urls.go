@@ -0,0 +1,28 @@
+package crawler
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const baseURL = "https://www.amazon.com"
+
+func productURL(asin string) string {
+	return fmt.Sprintf("%s/dp/%s", baseURL, asin)
+}
+
+func searchURL(keyword string, page int) string {
+	return fmt.Sprintf("%s/s?k=%s&page=%d", baseURL, url.QueryEscape(keyword), page)
+}
+
+func categoryURL(node string, page int) string {
+	return fmt.Sprintf("%s/b?node=%s&page=%d", baseURL, url.QueryEscape(node), page)
+}
+
+func reviewsURL(asin string, page int) string {
+	return fmt.Sprintf("%s/product-reviews/%s/?pageNumber=%d", baseURL, asin, page)
+}
+
+func sellerListingsURL(sellerID string, page int) string {
+	return fmt.Sprintf("%s/s?me=%s&page=%d", baseURL, url.QueryEscape(sellerID), page)
+}
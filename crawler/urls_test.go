@@ -0,0 +1,25 @@
+package crawler
+
+import "testing"
+
+func TestURLBuilders(t *testing.T) {
+	cases := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"product", productURL("B0FB21526X"), "https://www.amazon.com/dp/B0FB21526X"},
+		{"search", searchURL("wireless mouse", 2), "https://www.amazon.com/s?k=wireless+mouse&page=2"},
+		{"category", categoryURL("172282", 1), "https://www.amazon.com/b?node=172282&page=1"},
+		{"reviews", reviewsURL("B0FB21526X", 3), "https://www.amazon.com/product-reviews/B0FB21526X/?pageNumber=3"},
+		{"seller", sellerListingsURL("A1B2C3", 1), "https://www.amazon.com/s?me=A1B2C3&page=1"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.want {
+				t.Errorf("got %q, want %q", tc.got, tc.want)
+			}
+		})
+	}
+}
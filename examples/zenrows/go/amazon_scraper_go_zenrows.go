@@ -1,24 +1,22 @@
 package main
 
 import (
-	"io"
+	"context"
 	"log"
-	"net/http"
+
+	"github.com/ZenRows/amazon-scraper/amazon"
 )
 
 func main() {
-	client := &http.Client{}
-	req, err := http.NewRequest("GET", "https://api.zenrows.com/v1/?apikey=<YOUR_ZENROWS_API_KEY>&url=https%3A%2F%2Fwww.amazon.com%2FLogitech-Master-Bluetooth-Wireless-Receiver%2Fdp%2FB0FB21526X&js_render=true&premium_proxy=true&autoparse=true", nil)
-	resp, err := client.Do(req)
-	if err != nil {
-		log.Fatalln(err)
-	}
-	defer resp.Body.Close()
+	client := amazon.New("<YOUR_ZENROWS_API_KEY>")
 
-	body, err := io.ReadAll(resp.Body)
+	product, err := client.FetchProduct(context.Background(),
+		"https://www.amazon.com/Logitech-Master-Bluetooth-Wireless-Receiver/dp/B0FB21526X",
+		amazon.RequestOptions{JSRender: true, PremiumProxy: true},
+	)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	log.Println(string(body))
+	log.Printf("%+v\n", product)
 }
@@ -0,0 +1,95 @@
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+)
+
+// defaultUserAgents is a small pool of common desktop browser UAs. Amazon
+// leans heavily on User-Agent and Accept-Language to fingerprint bots, so
+// DirectFetcher rotates through both on every request.
+var defaultUserAgents = []string{
+	"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+	"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+	"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+	"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Safari/605.1.15",
+}
+
+var defaultAcceptLanguages = []string{
+	"en-US,en;q=0.9",
+	"en-GB,en;q=0.9",
+	"en-US,en;q=0.8,es;q=0.6",
+}
+
+// DirectFetcher fetches targetURL directly with net/http, with no scraping
+// gateway in front of it. It's meant for pages that aren't blocked, as a
+// free fallback alongside a paid Fetcher; Amazon will still rate-limit or
+// CAPTCHA it under sustained load.
+type DirectFetcher struct {
+	httpClient      *http.Client
+	userAgents      []string
+	acceptLanguages []string
+	rngSource       func(n int) int
+}
+
+// DirectOption configures a DirectFetcher constructed with NewDirectFetcher.
+type DirectOption func(*DirectFetcher)
+
+// WithDirectHTTPClient overrides the http.Client used for requests.
+func WithDirectHTTPClient(hc *http.Client) DirectOption {
+	return func(f *DirectFetcher) {
+		f.httpClient = hc
+	}
+}
+
+// WithUserAgents overrides the User-Agent pool rotated across requests.
+func WithUserAgents(userAgents []string) DirectOption {
+	return func(f *DirectFetcher) {
+		f.userAgents = userAgents
+	}
+}
+
+// WithAcceptLanguages overrides the Accept-Language pool rotated across
+// requests.
+func WithAcceptLanguages(acceptLanguages []string) DirectOption {
+	return func(f *DirectFetcher) {
+		f.acceptLanguages = acceptLanguages
+	}
+}
+
+// NewDirectFetcher creates a Fetcher that issues plain HTTP requests,
+// rotating User-Agent and Accept-Language on every call.
+func NewDirectFetcher(opts ...DirectOption) *DirectFetcher {
+	f := &DirectFetcher{
+		httpClient:      http.DefaultClient,
+		userAgents:      defaultUserAgents,
+		acceptLanguages: defaultAcceptLanguages,
+		rngSource:       rand.Intn,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Fetch requests targetURL directly. opts.JSRender, PremiumProxy,
+// ProxyCountry, and CSSExtractor have no effect: a direct request can't
+// render JS or route through a proxy.
+func (f *DirectFetcher) Fetch(ctx context.Context, targetURL string, _ FetchOptions) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: building direct request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", f.userAgents[f.rngSource(len(f.userAgents))])
+	req.Header.Set("Accept-Language", f.acceptLanguages[f.rngSource(len(f.acceptLanguages))])
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: performing direct request: %w", err)
+	}
+	return resp, nil
+}
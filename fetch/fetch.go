@@ -0,0 +1,33 @@
+// Package fetch defines a backend-agnostic way to retrieve a URL through
+// a scraping gateway (or directly), so higher-level packages like amazon
+// aren't tied to any one provider.
+package fetch
+
+import (
+	"context"
+	"net/http"
+)
+
+// FetchOptions configures a single fetch. Not every Fetcher implementation
+// supports every field; unsupported fields are silently ignored.
+type FetchOptions struct {
+	// JSRender requests headless browser rendering for pages that
+	// hydrate content client-side.
+	JSRender bool
+	// PremiumProxy requests a residential/anti-block proxy pool.
+	PremiumProxy bool
+	// ProxyCountry pins the exit IP to a country code, e.g. "us".
+	ProxyCountry string
+	// WaitFor is a CSS selector the backend should wait to appear before
+	// returning the page. Requires JSRender.
+	WaitFor string
+	// CSSExtractor is a backend-specific CSS extraction ruleset for
+	// fields the backend's own parser doesn't cover.
+	CSSExtractor string
+}
+
+// Fetcher retrieves targetURL through some backend (a scraping gateway, or
+// a direct HTTP request) and returns the raw HTTP response.
+type Fetcher interface {
+	Fetch(ctx context.Context, targetURL string, opts FetchOptions) (*http.Response, error)
+}
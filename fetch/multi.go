@@ -0,0 +1,78 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// MultiFetcher tries a sequence of Fetchers in order, falling through to
+// the next one when a backend errors, gets rate-limited/blocked (403,
+// 503), or returns a CAPTCHA page instead of real content.
+type MultiFetcher struct {
+	fetchers []Fetcher
+}
+
+// NewMultiFetcher creates a MultiFetcher that tries fetchers in the given
+// order, returning the first response that doesn't look blocked.
+func NewMultiFetcher(fetchers ...Fetcher) *MultiFetcher {
+	return &MultiFetcher{fetchers: fetchers}
+}
+
+// Fetch tries each backend in order, returning the first response that
+// isn't a block/CAPTCHA page. If every backend is blocked, it returns the
+// last backend's response anyway so the caller can inspect it.
+func (m *MultiFetcher) Fetch(ctx context.Context, targetURL string, opts FetchOptions) (*http.Response, error) {
+	if len(m.fetchers) == 0 {
+		return nil, errors.New("fetch: MultiFetcher has no backends configured")
+	}
+
+	var lastErr error
+	for i, f := range m.fetchers {
+		resp, err := f.Fetch(ctx, targetURL, opts)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = fmt.Errorf("fetch: reading backend %d response: %w", i, err)
+			continue
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		if !looksBlocked(resp.StatusCode, body) || i == len(m.fetchers)-1 {
+			return resp, nil
+		}
+		lastErr = fmt.Errorf("fetch: backend %d returned a blocked/CAPTCHA response (status %d)", i, resp.StatusCode)
+	}
+	return nil, lastErr
+}
+
+// looksBlocked is a minimal, dependency-free heuristic for "this backend
+// got blocked, try the next one". It checks only block statuses and a few
+// CAPTCHA markers; it does not flag short 200 bodies the way antibot.Check
+// does.
+func looksBlocked(statusCode int, body []byte) bool {
+	if statusCode == http.StatusForbidden || statusCode == http.StatusServiceUnavailable {
+		return true
+	}
+
+	lower := bytes.ToLower(body)
+	markers := [][]byte{
+		[]byte("enter the characters you see below"),
+		[]byte("robot check"),
+		[]byte("to discuss automated access"),
+	}
+	for _, marker := range markers {
+		if bytes.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package fetch
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type stubFetcher struct {
+	statusCode int
+	body       string
+	err        error
+	calls      int
+}
+
+func (f *stubFetcher) Fetch(_ context.Context, _ string, _ FetchOptions) (*http.Response, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &http.Response{
+		StatusCode: f.statusCode,
+		Body:       io.NopCloser(strings.NewReader(f.body)),
+	}, nil
+}
+
+func TestMultiFetcherFallsBackOnBlock(t *testing.T) {
+	blocked := &stubFetcher{statusCode: http.StatusForbidden, body: "Robot Check"}
+	clean := &stubFetcher{statusCode: http.StatusOK, body: `{"asin":"B000000000"}`}
+
+	m := NewMultiFetcher(blocked, clean)
+	resp, err := m.Fetch(context.Background(), "https://example.com", FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if blocked.calls != 1 || clean.calls != 1 {
+		t.Errorf("calls = (%d, %d), want (1, 1)", blocked.calls, clean.calls)
+	}
+}
+
+func TestMultiFetcherReturnsLastResponseWhenAllBlocked(t *testing.T) {
+	first := &stubFetcher{statusCode: http.StatusForbidden, body: "Robot Check"}
+	second := &stubFetcher{statusCode: http.StatusServiceUnavailable, body: "Robot Check"}
+
+	m := NewMultiFetcher(first, second)
+	resp, err := m.Fetch(context.Background(), "https://example.com", FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,94 @@
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const defaultScraperAPIBaseURL = "https://api.scraperapi.com/"
+
+// ScraperAPIFetcher fetches pages through a ScraperAPI/Zenscrape-style
+// gateway: a single endpoint taking an api_key and a url query parameter,
+// with render/premium/country flags of its own.
+type ScraperAPIFetcher struct {
+	apiKey     string
+	httpClient *http.Client
+	baseURL    string
+}
+
+// ScraperAPIOption configures a ScraperAPIFetcher constructed with
+// NewScraperAPIFetcher.
+type ScraperAPIOption func(*ScraperAPIFetcher)
+
+// WithScraperAPIHTTPClient overrides the http.Client used for requests.
+func WithScraperAPIHTTPClient(hc *http.Client) ScraperAPIOption {
+	return func(f *ScraperAPIFetcher) {
+		f.httpClient = hc
+	}
+}
+
+// WithScraperAPIBaseURL overrides the gateway endpoint, so the same
+// fetcher shape also covers Zenscrape-style APIs.
+func WithScraperAPIBaseURL(baseURL string) ScraperAPIOption {
+	return func(f *ScraperAPIFetcher) {
+		f.baseURL = baseURL
+	}
+}
+
+// NewScraperAPIFetcher creates a Fetcher that authenticates with a
+// ScraperAPI-style gateway using apiKey.
+func NewScraperAPIFetcher(apiKey string, opts ...ScraperAPIOption) *ScraperAPIFetcher {
+	f := &ScraperAPIFetcher{
+		apiKey:     apiKey,
+		httpClient: http.DefaultClient,
+		baseURL:    defaultScraperAPIBaseURL,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Fetch requests targetURL through the gateway with opts translated to
+// its render/premium/country query parameters.
+func (f *ScraperAPIFetcher) Fetch(ctx context.Context, targetURL string, opts FetchOptions) (*http.Response, error) {
+	reqURL, err := f.buildURL(targetURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: building scraperapi request: %w", err)
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: performing scraperapi request: %w", err)
+	}
+	return resp, nil
+}
+
+func (f *ScraperAPIFetcher) buildURL(targetURL string, opts FetchOptions) (string, error) {
+	base, err := url.Parse(f.baseURL)
+	if err != nil {
+		return "", fmt.Errorf("fetch: invalid scraperapi base URL: %w", err)
+	}
+
+	values := base.Query()
+	values.Set("api_key", f.apiKey)
+	values.Set("url", targetURL)
+	if opts.JSRender {
+		values.Set("render", "true")
+	}
+	if opts.PremiumProxy {
+		values.Set("premium", "true")
+	}
+	if opts.ProxyCountry != "" {
+		values.Set("country_code", opts.ProxyCountry)
+	}
+	base.RawQuery = values.Encode()
+	return base.String(), nil
+}
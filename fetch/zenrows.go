@@ -0,0 +1,98 @@
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const defaultZenRowsBaseURL = "https://api.zenrows.com/v1/"
+
+// ZenRowsFetcher fetches pages through the ZenRows scraping API.
+type ZenRowsFetcher struct {
+	apikey     string
+	httpClient *http.Client
+	baseURL    string
+}
+
+// ZenRowsOption configures a ZenRowsFetcher constructed with NewZenRowsFetcher.
+type ZenRowsOption func(*ZenRowsFetcher)
+
+// WithZenRowsHTTPClient overrides the http.Client used for requests.
+func WithZenRowsHTTPClient(hc *http.Client) ZenRowsOption {
+	return func(f *ZenRowsFetcher) {
+		f.httpClient = hc
+	}
+}
+
+// WithZenRowsBaseURL overrides the ZenRows endpoint, mainly for testing
+// against a local server.
+func WithZenRowsBaseURL(baseURL string) ZenRowsOption {
+	return func(f *ZenRowsFetcher) {
+		f.baseURL = baseURL
+	}
+}
+
+// NewZenRowsFetcher creates a Fetcher that authenticates with ZenRows
+// using apikey and requests autoparse=true on every fetch.
+func NewZenRowsFetcher(apikey string, opts ...ZenRowsOption) *ZenRowsFetcher {
+	f := &ZenRowsFetcher{
+		apikey:     apikey,
+		httpClient: http.DefaultClient,
+		baseURL:    defaultZenRowsBaseURL,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Fetch requests targetURL through ZenRows with opts applied as query
+// parameters, plus autoparse=true.
+func (f *ZenRowsFetcher) Fetch(ctx context.Context, targetURL string, opts FetchOptions) (*http.Response, error) {
+	reqURL, err := f.buildURL(targetURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: building zenrows request: %w", err)
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: performing zenrows request: %w", err)
+	}
+	return resp, nil
+}
+
+func (f *ZenRowsFetcher) buildURL(targetURL string, opts FetchOptions) (string, error) {
+	base, err := url.Parse(f.baseURL)
+	if err != nil {
+		return "", fmt.Errorf("fetch: invalid zenrows base URL: %w", err)
+	}
+
+	values := base.Query()
+	values.Set("apikey", f.apikey)
+	values.Set("url", targetURL)
+	values.Set("autoparse", "true")
+	if opts.JSRender {
+		values.Set("js_render", "true")
+	}
+	if opts.PremiumProxy {
+		values.Set("premium_proxy", "true")
+	}
+	if opts.ProxyCountry != "" {
+		values.Set("proxy_country", opts.ProxyCountry)
+	}
+	if opts.WaitFor != "" {
+		values.Set("wait_for", opts.WaitFor)
+	}
+	if opts.CSSExtractor != "" {
+		values.Set("css_extractor", opts.CSSExtractor)
+	}
+	base.RawQuery = values.Encode()
+	return base.String(), nil
+}
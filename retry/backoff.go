@@ -0,0 +1,48 @@
+package retry
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Backoff computes the exponential-with-jitter delay between retry
+// attempts, capped at Max.
+type Backoff struct {
+	// Base is the delay before the first retry.
+	Base time.Duration
+	// Max caps the computed delay, however high attempt climbs.
+	Max time.Duration
+	// Jitter is the fraction (0-1) of the computed delay randomized to
+	// spread out retries from concurrent callers.
+	Jitter float64
+}
+
+// DefaultBackoff starts at 500ms, doubles per attempt, caps at 30s, and
+// jitters by up to 20%.
+var DefaultBackoff = Backoff{
+	Base:   500 * time.Millisecond,
+	Max:    30 * time.Second,
+	Jitter: 0.2,
+}
+
+// Delay returns the backoff duration before retry attempt n (n starts at 1).
+func (b Backoff) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := float64(b.Base) * math.Pow(2, float64(attempt-1))
+	if max := float64(b.Max); delay > max {
+		delay = max
+	}
+
+	if b.Jitter > 0 {
+		jitter := delay * b.Jitter
+		delay += jitter*rand.Float64()*2 - jitter
+		if delay < 0 {
+			delay = 0
+		}
+	}
+	return time.Duration(delay)
+}
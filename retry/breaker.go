@@ -0,0 +1,82 @@
+package retry
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by a retrying Fetcher when its circuit
+// breaker has tripped and is refusing new requests.
+var ErrCircuitOpen = errors.New("retry: circuit breaker is open")
+
+// DefaultCircuitBreakerCooldown is how long CircuitBreaker waits after
+// tripping before it lets a trial request probe the backend again.
+const DefaultCircuitBreakerCooldown = 30 * time.Second
+
+// CircuitBreaker trips after threshold consecutive failures, then refuses
+// requests until either a trial request succeeds or the cooldown elapses
+// and a probe request is let through. It exists to stop a bad run (e.g.
+// an expired API key) from burning through retries on every request,
+// while still recovering on its own once the underlying problem clears.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu          sync.Mutex
+	consecutive int
+	open        bool
+	openedAt    time.Time
+}
+
+// NewCircuitBreaker creates a CircuitBreaker that trips after threshold
+// consecutive failures and probes again after DefaultCircuitBreakerCooldown.
+// threshold <= 0 disables tripping.
+func NewCircuitBreaker(threshold int) *CircuitBreaker {
+	return newCircuitBreaker(threshold, DefaultCircuitBreakerCooldown)
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+// Allow reports whether a request should proceed: the circuit is closed,
+// or it's open but the cooldown has elapsed and this request is a probe.
+func (cb *CircuitBreaker) Allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if !cb.open {
+		return true
+	}
+	if time.Since(cb.openedAt) < cb.cooldown {
+		return false
+	}
+	// This request is the probe: hold other requests back for another
+	// cooldown until its outcome is recorded.
+	cb.openedAt = time.Now()
+	return true
+}
+
+// RecordSuccess resets the failure count and closes the circuit.
+func (cb *CircuitBreaker) RecordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.consecutive = 0
+	cb.open = false
+}
+
+// RecordFailure counts a failure, (re-)tripping the circuit once
+// threshold consecutive failures have been recorded, including a failed
+// probe after the cooldown.
+func (cb *CircuitBreaker) RecordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.threshold <= 0 {
+		return
+	}
+	cb.consecutive++
+	if cb.consecutive >= cb.threshold {
+		cb.open = true
+		cb.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,36 @@
+package retry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerTripsAndBlocksWithinCooldown(t *testing.T) {
+	cb := newCircuitBreaker(2, time.Hour)
+	cb.RecordFailure()
+	if !cb.Allow() {
+		t.Fatalf("Allow() = false after 1 failure, want true (threshold is 2)")
+	}
+	cb.RecordFailure()
+	if cb.Allow() {
+		t.Fatalf("Allow() = true after tripping, want false within cooldown")
+	}
+}
+
+func TestCircuitBreakerProbesAfterCooldown(t *testing.T) {
+	cb := newCircuitBreaker(1, time.Millisecond)
+	cb.RecordFailure()
+	if cb.Allow() {
+		t.Fatalf("Allow() = true immediately after tripping, want false")
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	if !cb.Allow() {
+		t.Fatalf("Allow() = false after cooldown elapsed, want true (probe request)")
+	}
+
+	cb.RecordSuccess()
+	if !cb.Allow() {
+		t.Fatalf("Allow() = false after a successful probe, want true (circuit closed)")
+	}
+}
@@ -0,0 +1,150 @@
+// Package retry wraps a fetch.Fetcher with exponential backoff retries, a
+// concurrency limiter, and a circuit breaker, so callers get resilience
+// without reimplementing it around every backend.
+package retry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ZenRows/amazon-scraper/fetch"
+)
+
+const defaultMaxRetries = 3
+
+// Option configures a Fetcher built with Wrap.
+type Option func(*retryingFetcher)
+
+// WithMaxRetries sets how many additional attempts are made after a
+// retryable failure, beyond the first. Defaults to 3.
+func WithMaxRetries(n int) Option {
+	return func(f *retryingFetcher) {
+		f.maxRetries = n
+	}
+}
+
+// WithBackoff overrides the delay strategy between retries. Defaults to
+// DefaultBackoff.
+func WithBackoff(b Backoff) Option {
+	return func(f *retryingFetcher) {
+		f.backoff = b
+	}
+}
+
+// WithConcurrency bounds how many requests this Fetcher keeps in flight at
+// once. Unset means unbounded.
+func WithConcurrency(n int) Option {
+	return func(f *retryingFetcher) {
+		f.limiter = NewLimiter(n)
+	}
+}
+
+// WithCircuitBreaker trips the circuit after threshold consecutive
+// quota/auth failures (401, 402, 403), short-circuiting further requests
+// with ErrCircuitOpen. After DefaultCircuitBreakerCooldown it lets a
+// single probe request through; a success closes the circuit again, a
+// failure re-trips it for another cooldown period.
+func WithCircuitBreaker(threshold int) Option {
+	return func(f *retryingFetcher) {
+		f.breaker = NewCircuitBreaker(threshold)
+	}
+}
+
+type retryingFetcher struct {
+	delegate   fetch.Fetcher
+	maxRetries int
+	backoff    Backoff
+	limiter    *Limiter
+	breaker    *CircuitBreaker
+}
+
+// Wrap returns a Fetcher that retries delegate with backoff on
+// 429/500/502/503/504 responses and transport errors, optionally bounding
+// concurrency and tripping a circuit breaker, per opts.
+func Wrap(delegate fetch.Fetcher, opts ...Option) fetch.Fetcher {
+	f := &retryingFetcher{
+		delegate:   delegate,
+		maxRetries: defaultMaxRetries,
+		backoff:    DefaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Fetch delegates to the wrapped Fetcher, retrying retryable failures with
+// backoff and honoring the configured limiter and circuit breaker.
+func (f *retryingFetcher) Fetch(ctx context.Context, targetURL string, opts fetch.FetchOptions) (*http.Response, error) {
+	if f.breaker != nil && !f.breaker.Allow() {
+		return nil, ErrCircuitOpen
+	}
+
+	if f.limiter != nil {
+		if err := f.limiter.Acquire(ctx); err != nil {
+			return nil, err
+		}
+		defer f.limiter.Release()
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= f.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(f.backoff.Delay(attempt)):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		resp, err := f.delegate.Fetch(ctx, targetURL, opts)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if attempt < f.maxRetries && isRetryableStatus(resp.StatusCode) {
+			resp.Body.Close()
+			lastErr = fmt.Errorf("retry: upstream returned status %d", resp.StatusCode)
+			continue
+		}
+
+		f.recordBreaker(resp.StatusCode)
+		return resp, nil
+	}
+
+	f.recordBreaker(0)
+	return nil, lastErr
+}
+
+func (f *retryingFetcher) recordBreaker(statusCode int) {
+	if f.breaker == nil {
+		return
+	}
+	if isQuotaOrAuthStatus(statusCode) {
+		f.breaker.RecordFailure()
+	} else {
+		f.breaker.RecordSuccess()
+	}
+}
+
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func isQuotaOrAuthStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusPaymentRequired, http.StatusForbidden:
+		return true
+	default:
+		return false
+	}
+}
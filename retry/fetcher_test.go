@@ -0,0 +1,85 @@
+package retry
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZenRows/amazon-scraper/fetch"
+)
+
+type stubFetcher struct {
+	statusCodes []int
+	call        int
+}
+
+func (f *stubFetcher) Fetch(_ context.Context, _ string, _ fetch.FetchOptions) (*http.Response, error) {
+	code := f.statusCodes[f.call]
+	if f.call < len(f.statusCodes)-1 {
+		f.call++
+	}
+	return &http.Response{
+		StatusCode: code,
+		Body:       io.NopCloser(strings.NewReader("body")),
+	}, nil
+}
+
+func TestWrapRetriesRetryableStatus(t *testing.T) {
+	delegate := &stubFetcher{statusCodes: []int{503, 503, 200}}
+	f := Wrap(delegate, WithMaxRetries(3), WithBackoff(Backoff{Base: time.Millisecond, Max: time.Millisecond, Jitter: 0}))
+
+	resp, err := f.Fetch(context.Background(), "https://example.com", fetch.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		t.Errorf("StatusCode = %d, want 200", resp.StatusCode)
+	}
+	if delegate.call != 2 {
+		t.Errorf("delegate retried %d times, want 2", delegate.call)
+	}
+}
+
+func TestWrapGivesUpAfterMaxRetries(t *testing.T) {
+	delegate := &stubFetcher{statusCodes: []int{503, 503, 503}}
+	f := Wrap(delegate, WithMaxRetries(1), WithBackoff(Backoff{Base: time.Millisecond, Max: time.Millisecond, Jitter: 0}))
+
+	resp, err := f.Fetch(context.Background(), "https://example.com", fetch.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 503 {
+		t.Errorf("StatusCode = %d, want 503 (retries exhausted)", resp.StatusCode)
+	}
+}
+
+func TestWrapCircuitBreakerTrips(t *testing.T) {
+	delegate := &stubFetcher{statusCodes: []int{402}}
+	f := Wrap(delegate, WithMaxRetries(0), WithCircuitBreaker(2))
+
+	for i := 0; i < 2; i++ {
+		resp, err := f.Fetch(context.Background(), "https://example.com", fetch.FetchOptions{})
+		if err != nil {
+			t.Fatalf("Fetch() attempt %d error = %v", i, err)
+		}
+		resp.Body.Close()
+	}
+
+	if _, err := f.Fetch(context.Background(), "https://example.com", fetch.FetchOptions{}); err != ErrCircuitOpen {
+		t.Errorf("Fetch() error = %v, want ErrCircuitOpen", err)
+	}
+}
+
+func TestBackoffDelayCapsAtMax(t *testing.T) {
+	b := Backoff{Base: time.Second, Max: 2 * time.Second, Jitter: 0}
+	if got := b.Delay(10); got != 2*time.Second {
+		t.Errorf("Delay(10) = %v, want capped at %v", got, 2*time.Second)
+	}
+}
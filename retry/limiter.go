@@ -0,0 +1,42 @@
+package retry
+
+import "context"
+
+// Limiter bounds how many requests a client keeps in flight at once, to
+// respect an account's concurrency budget. It's a thin semaphore rather
+// than a timed rate limiter: ZenRows-style plans cap concurrent requests,
+// not requests per second.
+type Limiter struct {
+	sem chan struct{}
+}
+
+// NewLimiter creates a Limiter allowing at most n concurrent acquisitions.
+// n <= 0 means unbounded: Acquire never blocks.
+func NewLimiter(n int) *Limiter {
+	if n <= 0 {
+		return &Limiter{}
+	}
+	return &Limiter{sem: make(chan struct{}, n)}
+}
+
+// Acquire blocks until a slot is free or ctx is done. It never blocks on
+// an unbounded Limiter.
+func (l *Limiter) Acquire(ctx context.Context) error {
+	if l.sem == nil {
+		return nil
+	}
+	select {
+	case l.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot acquired with Acquire.
+func (l *Limiter) Release() {
+	if l.sem == nil {
+		return
+	}
+	<-l.sem
+}
@@ -0,0 +1,38 @@
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterZeroIsUnbounded(t *testing.T) {
+	l := NewLimiter(0)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	for i := 0; i < 10; i++ {
+		if err := l.Acquire(ctx); err != nil {
+			t.Fatalf("Acquire() #%d error = %v, want nil (unbounded)", i, err)
+		}
+	}
+}
+
+func TestLimiterBoundsConcurrency(t *testing.T) {
+	l := NewLimiter(1)
+	ctx := context.Background()
+	if err := l.Acquire(ctx); err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+
+	blockedCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if err := l.Acquire(blockedCtx); err == nil {
+		t.Fatalf("Acquire() error = nil, want deadline exceeded while slot is held")
+	}
+
+	l.Release()
+	if err := l.Acquire(ctx); err != nil {
+		t.Fatalf("Acquire() after Release() error = %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// JSONLSink buffers items in memory keyed by ASIN and writes them out as
+// newline-delimited JSON, one line per ASIN, sorted for a deterministic
+// diff between runs. It's a Sink, not an append-only log: writing happens
+// on Close, so a given ASIN never appears twice in the file.
+type JSONLSink struct {
+	w io.WriteCloser
+
+	mu    sync.Mutex
+	items map[string]CatalogItem
+}
+
+// NewJSONLSink creates a JSONLSink that writes to w on Close.
+func NewJSONLSink(w io.WriteCloser) *JSONLSink {
+	return &JSONLSink{
+		w:     w,
+		items: make(map[string]CatalogItem),
+	}
+}
+
+// Upsert stores item in memory, replacing any prior item with the same
+// ASIN.
+func (s *JSONLSink) Upsert(_ context.Context, item CatalogItem) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items[item.ASIN] = item
+	return nil
+}
+
+// Close writes every buffered item as a JSON line, ordered by ASIN, then
+// closes the underlying writer.
+func (s *JSONLSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	asins := make([]string, 0, len(s.items))
+	for asin := range s.items {
+		asins = append(asins, asin)
+	}
+	sort.Strings(asins)
+
+	enc := json.NewEncoder(s.w)
+	for _, asin := range asins {
+		if err := enc.Encode(s.items[asin]); err != nil {
+			s.w.Close()
+			return fmt.Errorf("sink: encoding %s: %w", asin, err)
+		}
+	}
+	return s.w.Close()
+}
@@ -0,0 +1,102 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+type nopWriteCloser struct {
+	*bytes.Buffer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func newBufferSink() (*JSONLSink, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	return NewJSONLSink(nopWriteCloser{buf}), buf
+}
+
+func TestJSONLSinkUpsertOverwritesByASIN(t *testing.T) {
+	s, buf := newBufferSink()
+	ctx := context.Background()
+
+	if err := s.Upsert(ctx, CatalogItem{ASIN: "B1", Attributes: ItemAttributes{Title: "first"}}); err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+	if err := s.Upsert(ctx, CatalogItem{ASIN: "B1", Attributes: ItemAttributes{Title: "second"}}); err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("got %d lines, want 1 (later upsert should replace, not append)", len(lines))
+	}
+
+	var got CatalogItem
+	if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got.Attributes.Title != "second" {
+		t.Errorf("Attributes.Title = %q, want %q", got.Attributes.Title, "second")
+	}
+}
+
+func TestJSONLSinkOrdersByASIN(t *testing.T) {
+	s, buf := newBufferSink()
+	ctx := context.Background()
+
+	for _, asin := range []string{"B3", "B1", "B2"} {
+		if err := s.Upsert(ctx, CatalogItem{ASIN: asin}); err != nil {
+			t.Fatalf("Upsert(%s) error = %v", asin, err)
+		}
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	dec := json.NewDecoder(strings.NewReader(buf.String()))
+	var got []string
+	for {
+		var item CatalogItem
+		if err := dec.Decode(&item); err != nil {
+			if err == io.EOF {
+				break
+			}
+			t.Fatalf("Decode: %v", err)
+		}
+		got = append(got, item.ASIN)
+	}
+
+	want := []string{"B1", "B2", "B3"}
+	for i, asin := range want {
+		if got[i] != asin {
+			t.Errorf("order[%d] = %s, want %s", i, got[i], asin)
+		}
+	}
+}
+
+func TestFromProduct(t *testing.T) {
+	seenAt := time.Unix(0, 0).UTC()
+	got := FromProduct(productFixture(), seenAt)
+
+	if got.ASIN != "B0FB21526X" {
+		t.Errorf("ASIN = %q, want %q", got.ASIN, "B0FB21526X")
+	}
+	if got.LastSeenAt != seenAt {
+		t.Errorf("LastSeenAt = %v, want %v", got.LastSeenAt, seenAt)
+	}
+	if len(got.Summaries) != 1 || got.Summaries[0].ItemName != "Logitech MX Master" {
+		t.Errorf("Summaries = %+v", got.Summaries)
+	}
+	if len(got.Offers) != 1 || got.Offers[0].SellerName != "Amazon.com" {
+		t.Errorf("Offers = %+v", got.Offers)
+	}
+}
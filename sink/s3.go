@@ -0,0 +1,61 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Uploader is the slice of an S3 client this package needs: putting an
+// object at a key. Both aws-sdk-go-v2's s3.Client and S3-compatible SDKs
+// (MinIO, R2) satisfy this with a small adapter, so S3Sink doesn't pull
+// in an SDK dependency of its own.
+type Uploader interface {
+	PutObject(ctx context.Context, key string, body []byte) error
+}
+
+// S3Sink writes one JSON object per ASIN to an S3(-compatible) bucket
+// through Uploader, keyed as "<prefix><asin>.json". Because each upload
+// is addressed by ASIN, re-uploading the same ASIN overwrites the prior
+// object: the same upsert semantics as the other sinks, enforced by S3
+// itself rather than by this package.
+type S3Sink struct {
+	uploader Uploader
+	prefix   string
+}
+
+// NewS3Sink creates an S3Sink that uploads through uploader, prefixing
+// every object key with prefix (e.g. "catalog/").
+func NewS3Sink(uploader Uploader, prefix string) *S3Sink {
+	return &S3Sink{uploader: uploader, prefix: prefix}
+}
+
+// Upsert marshals item and uploads it to its ASIN-keyed object.
+func (s *S3Sink) Upsert(ctx context.Context, item CatalogItem) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(item); err != nil {
+		return fmt.Errorf("sink: encoding %s: %w", item.ASIN, err)
+	}
+
+	key := fmt.Sprintf("%s%s.json", s.prefix, sanitizeKeySegment(item.ASIN))
+	if err := s.uploader.PutObject(ctx, key, buf.Bytes()); err != nil {
+		return fmt.Errorf("sink: uploading %s: %w", key, err)
+	}
+	return nil
+}
+
+// Close is a no-op: S3Sink holds no resources of its own to release.
+func (s *S3Sink) Close() error {
+	return nil
+}
+
+// sanitizeKeySegment strips path separators from an ASIN before it's used
+// in an object key, so a malformed or unexpected ASIN can't write outside
+// s.prefix.
+func sanitizeKeySegment(segment string) string {
+	segment = strings.ReplaceAll(segment, "/", "")
+	segment = strings.ReplaceAll(segment, "\\", "")
+	return segment
+}
@@ -0,0 +1,91 @@
+// Package sink writes scraped products to pluggable destinations using a
+// schema shaped like Amazon's Selling Partner API catalog resources, so
+// scraped data can flow into the same tables/code paths as GetCatalogItem
+// results.
+package sink
+
+import (
+	"time"
+
+	"github.com/ZenRows/amazon-scraper/amazon"
+)
+
+// CatalogItem mirrors the shape of an SP-API catalog item: an ASIN plus
+// the marketplace summary, attributes, and offers attached to it.
+type CatalogItem struct {
+	ASIN       string                     `json:"asin"`
+	Summaries  []ItemSummaryByMarketplace `json:"summaries,omitempty"`
+	Attributes ItemAttributes             `json:"attributes"`
+	Offers     []Offer                    `json:"offers,omitempty"`
+	// LastSeenAt tracks when this item was last scraped, for change
+	// detection across repeated crawls.
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+// ItemSummaryByMarketplace mirrors SP-API's itemSummaryByMarketplace
+// entries: the subset of a catalog item's summary scoped to one
+// marketplace.
+type ItemSummaryByMarketplace struct {
+	MarketplaceID string  `json:"marketplaceId,omitempty"`
+	Brand         string  `json:"brand,omitempty"`
+	ItemName      string  `json:"itemName,omitempty"`
+	Price         float64 `json:"price,omitempty"`
+	Currency      string  `json:"currency,omitempty"`
+	Rating        float64 `json:"rating,omitempty"`
+	ReviewsCount  int     `json:"reviewsCount,omitempty"`
+}
+
+// ItemAttributes mirrors SP-API's itemAttributes: the descriptive,
+// marketplace-independent content for an item.
+type ItemAttributes struct {
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	BulletPoint []string `json:"bullet_point,omitempty"`
+	Images      []string `json:"images,omitempty"`
+}
+
+// Offer mirrors SP-API's offer resource: one seller's listing for an item.
+type Offer struct {
+	SellerName     string  `json:"sellerName,omitempty"`
+	Price          float64 `json:"price,omitempty"`
+	Currency       string  `json:"currency,omitempty"`
+	Condition      string  `json:"condition,omitempty"`
+	IsBuyBoxWinner bool    `json:"isBuyBoxWinner,omitempty"`
+}
+
+// FromProduct converts a scraped amazon.Product into the SP-API-shaped
+// CatalogItem a Sink persists, stamping LastSeenAt with seenAt.
+func FromProduct(p amazon.Product, seenAt time.Time) CatalogItem {
+	summary := ItemSummaryByMarketplace{
+		Brand:        p.Brand,
+		ItemName:     p.Title,
+		Price:        float64(p.Price),
+		Currency:     p.Currency,
+		Rating:       float64(p.Rating),
+		ReviewsCount: p.ReviewsCount,
+	}
+
+	offers := make([]Offer, 0, len(p.Offers))
+	for _, o := range p.Offers {
+		offers = append(offers, Offer{
+			SellerName:     o.SellerName,
+			Price:          float64(o.Price),
+			Currency:       o.Currency,
+			Condition:      o.Condition,
+			IsBuyBoxWinner: o.IsBuyBoxWinner,
+		})
+	}
+
+	return CatalogItem{
+		ASIN:      p.ASIN,
+		Summaries: []ItemSummaryByMarketplace{summary},
+		Attributes: ItemAttributes{
+			Title:       p.Title,
+			Description: p.Description,
+			BulletPoint: p.Features,
+			Images:      p.Images,
+		},
+		Offers:     offers,
+		LastSeenAt: seenAt,
+	}
+}
@@ -0,0 +1,20 @@
+package sink
+
+import "github.com/ZenRows/amazon-scraper/amazon"
+
+func productFixture() amazon.Product {
+	return amazon.Product{
+		ASIN:        "B0FB21526X",
+		Title:       "Logitech MX Master",
+		Brand:       "Logitech",
+		Price:       49.99,
+		Currency:    "USD",
+		Rating:      4.5,
+		Description: "A wireless mouse.",
+		Features:    []string{"Bluetooth", "USB receiver"},
+		Images:      []string{"https://example.com/image.jpg"},
+		Offers: []amazon.Offer{
+			{SellerName: "Amazon.com", Price: 49.99, Currency: "USD", IsBuyBoxWinner: true},
+		},
+	}
+}
@@ -0,0 +1,13 @@
+package sink
+
+import "context"
+
+// Sink persists scraped catalog items, keyed by ASIN. Upsert must be safe
+// to call repeatedly for the same ASIN across crawls: later calls replace
+// the stored item and refresh LastSeenAt rather than duplicating it.
+type Sink interface {
+	Upsert(ctx context.Context, item CatalogItem) error
+	// Close flushes any buffered state and releases underlying
+	// resources (files, connections, clients).
+	Close() error
+}
@@ -0,0 +1,119 @@
+package sink
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// Dialect selects the positional-parameter syntax SQLSink uses to build
+// its upsert statement, since database/sql doesn't normalize it across
+// drivers.
+type Dialect int
+
+const (
+	// SQLite uses "?" placeholders (modernc.org/sqlite, mattn/go-sqlite3).
+	SQLite Dialect = iota
+	// Postgres uses "$1"-style placeholders (lib/pq, jackc/pgx).
+	Postgres
+)
+
+func (d Dialect) placeholder(position int) string {
+	if d == Postgres {
+		return fmt.Sprintf("$%d", position)
+	}
+	return "?"
+}
+
+// SQLSink persists catalog items to a SQL database via database/sql,
+// upserting by ASIN on conflict. It depends only on database/sql and the
+// *sql.DB the caller opened, so this package doesn't need a driver of its
+// own: pass a *sql.DB opened with modernc.org/sqlite for SQLite, or
+// lib/pq/pgx for Postgres, naming the matching Dialect.
+type SQLSink struct {
+	db      *sql.DB
+	table   string
+	dialect Dialect
+}
+
+const defaultTable = "catalog_items"
+
+// NewSQLSink creates a SQLSink writing to table (defaulting to
+// "catalog_items" when empty) through db, using dialect's placeholder
+// syntax.
+func NewSQLSink(db *sql.DB, table string, dialect Dialect) *SQLSink {
+	if table == "" {
+		table = defaultTable
+	}
+	return &SQLSink{db: db, table: table, dialect: dialect}
+}
+
+// CreateTable creates the destination table if it doesn't already exist,
+// using column types supported by both SQLite and Postgres.
+func (s *SQLSink) CreateTable(ctx context.Context) error {
+	stmt := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		asin TEXT PRIMARY KEY,
+		title TEXT,
+		brand TEXT,
+		price REAL,
+		currency TEXT,
+		rating REAL,
+		reviews_count INTEGER,
+		attributes TEXT,
+		offers TEXT,
+		last_seen_at TIMESTAMP
+	)`, s.table)
+
+	if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+		return fmt.Errorf("sink: creating table %s: %w", s.table, err)
+	}
+	return nil
+}
+
+// Upsert inserts item, or updates the existing row for its ASIN,
+// refreshing last_seen_at.
+func (s *SQLSink) Upsert(ctx context.Context, item CatalogItem) error {
+	attributes, err := json.Marshal(item.Attributes)
+	if err != nil {
+		return fmt.Errorf("sink: marshaling attributes for %s: %w", item.ASIN, err)
+	}
+	offers, err := json.Marshal(item.Offers)
+	if err != nil {
+		return fmt.Errorf("sink: marshaling offers for %s: %w", item.ASIN, err)
+	}
+
+	var summary ItemSummaryByMarketplace
+	if len(item.Summaries) > 0 {
+		summary = item.Summaries[0]
+	}
+
+	p := s.dialect.placeholder
+	stmt := fmt.Sprintf(`INSERT INTO %s
+			(asin, title, brand, price, currency, rating, reviews_count, attributes, offers, last_seen_at)
+		VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+		ON CONFLICT(asin) DO UPDATE SET
+			title = excluded.title,
+			brand = excluded.brand,
+			price = excluded.price,
+			currency = excluded.currency,
+			rating = excluded.rating,
+			reviews_count = excluded.reviews_count,
+			attributes = excluded.attributes,
+			offers = excluded.offers,
+			last_seen_at = excluded.last_seen_at`,
+		s.table, p(1), p(2), p(3), p(4), p(5), p(6), p(7), p(8), p(9), p(10))
+
+	_, err = s.db.ExecContext(ctx, stmt,
+		item.ASIN, summary.ItemName, summary.Brand, summary.Price, summary.Currency,
+		summary.Rating, summary.ReviewsCount, attributes, offers, item.LastSeenAt)
+	if err != nil {
+		return fmt.Errorf("sink: upserting %s: %w", item.ASIN, err)
+	}
+	return nil
+}
+
+// Close closes the underlying *sql.DB.
+func (s *SQLSink) Close() error {
+	return s.db.Close()
+}
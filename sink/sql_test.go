@@ -0,0 +1,12 @@
+package sink
+
+import "testing"
+
+func TestDialectPlaceholder(t *testing.T) {
+	if got := SQLite.placeholder(3); got != "?" {
+		t.Errorf("SQLite.placeholder(3) = %q, want %q", got, "?")
+	}
+	if got := Postgres.placeholder(3); got != "$3" {
+		t.Errorf("Postgres.placeholder(3) = %q, want %q", got, "$3")
+	}
+}